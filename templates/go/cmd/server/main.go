@@ -1,22 +1,20 @@
 package main
 
 import (
-	"context"
-	"fmt"
 	"net/http"
-	"os"
-	"os/signal"
-	"syscall"
-	"time"
 
-	"github.com/gin-gonic/gin"
+	"github.com/go-chi/chi/v5"
 	"github.com/joho/godotenv"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.uber.org/fx"
+	"go.uber.org/fx/fxevent"
 	"go.uber.org/zap"
 
 	"github.com/munbon/{{SERVICE_NAME}}/internal/config"
 	"github.com/munbon/{{SERVICE_NAME}}/internal/handlers"
+	"github.com/munbon/{{SERVICE_NAME}}/internal/metrics"
 	"github.com/munbon/{{SERVICE_NAME}}/internal/middleware"
+	"github.com/munbon/{{SERVICE_NAME}}/internal/server"
 	"github.com/munbon/{{SERVICE_NAME}}/pkg/logger"
 )
 
@@ -24,67 +22,58 @@ func main() {
 	// Load environment variables
 	_ = godotenv.Load()
 
-	// Initialize logger
-	log := logger.New()
-	defer log.Sync()
-
-	// Load configuration
-	cfg := config.Load()
-
-	// Set Gin mode
-	if cfg.Environment == "production" {
-		gin.SetMode(gin.ReleaseMode)
-	}
-
-	// Create Gin router
-	router := gin.New()
-
-	// Add middleware
-	router.Use(middleware.Logger(log))
-	router.Use(middleware.Recovery(log))
-	router.Use(middleware.CORS())
-
-	// Health check endpoints
-	router.GET("/health", handlers.HealthCheck)
-	router.GET("/health/ready", handlers.ReadinessCheck)
-
-	// Metrics endpoint
-	router.GET("/metrics", gin.WrapH(promhttp.Handler()))
-
-	// API routes
-	api := router.Group("/api/v1")
-	{
+	fx.New(
+		fx.Provide(
+			config.New,
+			logger.New,
+			metrics.New,
+			handlers.NewHealthHandler,
+			middleware.New,
+			newRouter,
+			server.New,
+		),
+		fx.Invoke(func(*http.Server) {}),
+		fx.WithLogger(func(log *zap.Logger) fxevent.Logger {
+			return &fxevent.ZapLogger{Logger: log}
+		}),
+	).Run()
+}
+
+// newRouter assembles the chi router and the shared middleware chain into
+// the http.Handler the server listens with. Downstream services add their
+// own routes here.
+func newRouter(cfg *config.Config, health *handlers.HealthHandler, reg *metrics.Registry, chain middleware.Chain) http.Handler {
+	r := chi.NewRouter()
+	// Record the matched route pattern (not the raw path) so Metrics
+	// labels by e.g. "/api/v1/users/{id}" instead of exploding cardinality.
+	r.Use(recordRoutePattern)
+
+	r.Get("/health", health.Health)
+	r.Get("/health/ready", health.Ready)
+
+	metricsHandler := middleware.BasicAuth(cfg.Monitoring.MetricsUser, cfg.Monitoring.MetricsPassword)(
+		promhttp.HandlerFor(reg, promhttp.HandlerOpts{}),
+	)
+	r.Get("/metrics", metricsHandler.ServeHTTP)
+
+	r.Route("/api/v1", func(r chi.Router) {
 		// Add your API routes here
-	}
-
-	// Create HTTP server
-	srv := &http.Server{
-		Addr:    fmt.Sprintf(":%s", cfg.Port),
-		Handler: router,
-	}
-
-	// Start server in a goroutine
-	go func() {
-		log.Info("Starting server", zap.String("port", cfg.Port))
-		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			log.Fatal("Failed to start server", zap.Error(err))
-		}
-	}()
-
-	// Wait for interrupt signal to gracefully shutdown
-	quit := make(chan os.Signal, 1)
-	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
-	<-quit
-
-	log.Info("Shutting down server...")
-
-	// Graceful shutdown with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
-
-	if err := srv.Shutdown(ctx); err != nil {
-		log.Fatal("Server forced to shutdown", zap.Error(err))
-	}
-
-	log.Info("Server shutdown complete")
-}
\ No newline at end of file
+	})
+
+	return chain(r)
+}
+
+// recordRoutePattern reports the chi route pattern matched for this
+// request to middleware.Metrics/Recovery once routing has resolved it. The
+// report is deferred so it also happens when the handler panics, before
+// Recovery's recover runs.
+func recordRoutePattern(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			if rctx := chi.RouteContext(r.Context()); rctx != nil {
+				middleware.SetRoute(r.Context(), rctx.RoutePattern())
+			}
+		}()
+		next.ServeHTTP(w, r)
+	})
+}
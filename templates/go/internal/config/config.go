@@ -1,28 +1,91 @@
+// Package config loads the service's runtime configuration from the
+// environment.
 package config
 
 import (
-	"os"
+	"fmt"
+
+	"github.com/kelseyhightower/envconfig"
 )
 
+// Server holds HTTP server settings.
+type Server struct {
+	Port        string `envconfig:"PORT" default:"8080"`
+	Environment string `envconfig:"ENVIRONMENT" default:"development"`
+}
+
+// Logger holds logging settings.
+type Logger struct {
+	Level string `envconfig:"LOG_LEVEL" default:"info"`
+}
+
+// Database holds Postgres connection settings.
+type Database struct {
+	Host     string `envconfig:"DB_HOST" default:"localhost"`
+	Port     int    `envconfig:"DB_PORT" default:"5432"`
+	Name     string `envconfig:"DB_NAME"`
+	User     string `envconfig:"DB_USER"`
+	Password string `envconfig:"DB_PASSWORD"`
+}
+
+// Redis holds Redis connection settings.
+type Redis struct {
+	Host string `envconfig:"REDIS_HOST" default:"localhost"`
+	Port int    `envconfig:"REDIS_PORT" default:"6379"`
+}
+
+// Monitoring holds settings for the /metrics endpoint.
+type Monitoring struct {
+	MetricsUser     string `envconfig:"METRICS_USER"`
+	MetricsPassword string `envconfig:"METRICS_PASSWORD"`
+}
+
+// CORS holds the allowed origins/methods/headers for the CORS middleware.
+type CORS struct {
+	AllowedOrigins []string `envconfig:"CORS_ALLOWED_ORIGINS" default:"*"`
+	AllowedMethods []string `envconfig:"CORS_ALLOWED_METHODS" default:"GET,POST,PUT,PATCH,DELETE,OPTIONS"`
+	AllowedHeaders []string `envconfig:"CORS_ALLOWED_HEADERS" default:"Content-Type,Authorization"`
+}
+
+// Tracing holds distributed tracing settings.
+type Tracing struct {
+	Enabled     bool   `envconfig:"TRACING_ENABLED" default:"false"`
+	ServiceName string `envconfig:"TRACING_SERVICE_NAME"`
+	Endpoint    string `envconfig:"TRACING_ENDPOINT"`
+}
+
+// Config holds the runtime configuration shared by every service
+// scaffolded from this template. Services that need additional settings
+// should add a sub-struct here (or declare their own config shape via
+// LoadFromEnv) rather than reimplementing env parsing.
 type Config struct {
-	ServiceName string
-	Environment string
-	Port        string
-	LogLevel    string
-}
-
-func Load() *Config {
-	return &Config{
-		ServiceName: getEnv("SERVICE_NAME", "{{SERVICE_NAME}}"),
-		Environment: getEnv("ENVIRONMENT", "development"),
-		Port:        getEnv("PORT", "8080"),
-		LogLevel:    getEnv("LOG_LEVEL", "info"),
-	}
+	ServiceName string `envconfig:"SERVICE_NAME" required:"true"`
+	Version     string `envconfig:"VERSION" default:"dev"`
+
+	Server     Server
+	Logger     Logger
+	Database   Database
+	Redis      Redis
+	Monitoring Monitoring
+	Tracing    Tracing
+	CORS       CORS
 }
 
-func getEnv(key, defaultValue string) string {
-	if value := os.Getenv(key); value != "" {
-		return value
+// New loads Config from the environment. It is an fx constructor: services
+// that add their own config shapes should follow the same
+// New() (*T, error) convention, typically by calling LoadFromEnv[T]().
+func New() (*Config, error) {
+	return LoadFromEnv[Config]()
+}
+
+// LoadFromEnv populates a T from environment variables via envconfig,
+// failing fast on a missing required or invalid field rather than silently
+// falling back to zero values. The error names the first such field only;
+// any later missing or invalid fields are not reported.
+func LoadFromEnv[T any]() (*T, error) {
+	var cfg T
+	if err := envconfig.Process("", &cfg); err != nil {
+		return nil, fmt.Errorf("config: %w", err)
 	}
-	return defaultValue
-}
\ No newline at end of file
+	return &cfg, nil
+}
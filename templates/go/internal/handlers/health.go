@@ -1,63 +1,202 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
+	"sync"
 	"time"
+
+	"github.com/munbon/{{SERVICE_NAME}}/internal/config"
+	"github.com/munbon/{{SERVICE_NAME}}/internal/health"
 )
 
+// defaultCheckTimeout bounds how long a single Checker may run before it is
+// considered failed.
+const defaultCheckTimeout = 2 * time.Second
+
+// CheckResult is the per-checker outcome reported in a HealthResponse.
+type CheckResult struct {
+	Status    string `json:"status"`
+	Error     string `json:"error,omitempty"`
+	LatencyMs int64  `json:"latency_ms"`
+}
+
 // HealthResponse represents the health check response
 type HealthResponse struct {
-	Status    string            `json:"status"`
-	Service   string            `json:"service"`
-	Version   string            `json:"version"`
-	Timestamp time.Time         `json:"timestamp"`
-	Checks    map[string]string `json:"checks,omitempty"`
+	Status    string                 `json:"status"`
+	Service   string                 `json:"service"`
+	Version   string                 `json:"version"`
+	Timestamp time.Time              `json:"timestamp"`
+	Checks    map[string]CheckResult `json:"checks,omitempty"`
 }
 
-// HealthHandler handles health check requests
+// HealthHandler handles health check requests, aggregating a registry of
+// health.Checkers into /health and /health/ready.
 type HealthHandler struct {
-	ServiceName string
-	Version     string
+	ServiceName  string
+	Version      string
+	CheckTimeout time.Duration
+
+	mu       sync.RWMutex
+	checkers []health.Checker
+	passed   map[string]bool
 }
 
-// NewHealthHandler creates a new health handler
-func NewHealthHandler(serviceName, version string) *HealthHandler {
+// NewHealthHandler creates a new health handler. It is an fx constructor,
+// wired from config rather than passed literal strings. Checkers are added
+// after construction via Register.
+func NewHealthHandler(cfg *config.Config) *HealthHandler {
 	return &HealthHandler{
-		ServiceName: serviceName,
-		Version:     version,
+		ServiceName:  cfg.ServiceName,
+		Version:      cfg.Version,
+		CheckTimeout: defaultCheckTimeout,
+		passed:       make(map[string]bool),
 	}
 }
 
-// Health handles the /health endpoint
+// Register adds a Checker to the registry. It is safe to call concurrently
+// and is typically done once at startup for each backend the service
+// depends on.
+func (h *HealthHandler) Register(c health.Checker) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.checkers = append(h.checkers, c)
+}
+
+// Health handles the /health endpoint. It runs every registered checker in
+// parallel and returns 503 if any required checker fails; optional
+// checkers are reported but never fail the response.
 func (h *HealthHandler) Health(w http.ResponseWriter, r *http.Request) {
+	results, healthy := h.runChecks(r.Context())
+
+	status := "healthy"
+	if !healthy {
+		status = "unhealthy"
+	}
+
 	response := HealthResponse{
-		Status:    "healthy",
+		Status:    status,
 		Service:   h.ServiceName,
 		Version:   h.Version,
 		Timestamp: time.Now().UTC(),
-		Checks: map[string]string{
-			"database": "ok",
-			"cache":    "ok",
-		},
+		Checks:    results,
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
+	if !healthy {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	} else {
+		w.WriteHeader(http.StatusOK)
+	}
 	json.NewEncoder(w).Encode(response)
 }
 
-// Ready handles the /ready endpoint
+// Ready handles the /health/ready endpoint. It only reports ready once every
+// required checker has succeeded at least once since startup, so a
+// service that boots before its dependencies are reachable doesn't
+// flap traffic in and out of rotation.
 func (h *HealthHandler) Ready(w http.ResponseWriter, r *http.Request) {
-	// Add readiness checks here (e.g., database connections, dependencies)
+	h.runChecks(r.Context())
+
+	ready := h.allRequiredPassedOnce()
+
 	response := HealthResponse{
 		Status:    "ready",
 		Service:   h.ServiceName,
 		Version:   h.Version,
 		Timestamp: time.Now().UTC(),
 	}
+	if !ready {
+		response.Status = "not_ready"
+	}
 
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
+	if !ready {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	} else {
+		w.WriteHeader(http.StatusOK)
+	}
 	json.NewEncoder(w).Encode(response)
-}
\ No newline at end of file
+}
+
+// runChecks runs every registered checker in parallel, bounding each by
+// h.CheckTimeout, and reports whether all required checkers passed.
+func (h *HealthHandler) runChecks(ctx context.Context) (map[string]CheckResult, bool) {
+	h.mu.RLock()
+	checkers := make([]health.Checker, len(h.checkers))
+	copy(checkers, h.checkers)
+	h.mu.RUnlock()
+
+	type outcome struct {
+		name   string
+		result CheckResult
+		err    error
+		req    bool
+	}
+
+	outcomes := make(chan outcome, len(checkers))
+	var wg sync.WaitGroup
+	wg.Add(len(checkers))
+
+	for _, c := range checkers {
+		go func(c health.Checker) {
+			defer wg.Done()
+
+			checkCtx, cancel := context.WithTimeout(ctx, h.CheckTimeout)
+			defer cancel()
+
+			start := time.Now()
+			err := c.Check(checkCtx)
+			latency := time.Since(start).Milliseconds()
+
+			result := CheckResult{Status: "ok", LatencyMs: latency}
+			if err != nil {
+				result.Status = "error"
+				result.Error = err.Error()
+			}
+
+			outcomes <- outcome{name: c.Name(), result: result, err: err, req: c.Required()}
+		}(c)
+	}
+
+	go func() {
+		wg.Wait()
+		close(outcomes)
+	}()
+
+	results := make(map[string]CheckResult, len(checkers))
+	healthy := true
+	passed := make(map[string]bool)
+
+	for o := range outcomes {
+		results[o.name] = o.result
+		if o.err == nil {
+			passed[o.name] = true
+		} else if o.req {
+			healthy = false
+		}
+	}
+
+	h.mu.Lock()
+	for name := range passed {
+		h.passed[name] = true
+	}
+	h.mu.Unlock()
+
+	return results, healthy
+}
+
+// allRequiredPassedOnce reports whether every registered required checker
+// has succeeded at least once since startup.
+func (h *HealthHandler) allRequiredPassedOnce() bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for _, c := range h.checkers {
+		if c.Required() && !h.passed[c.Name()] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// fakeChecker is a test-only health.Checker.
+type fakeChecker struct {
+	name     string
+	required bool
+	err      error
+}
+
+func (c *fakeChecker) Name() string                    { return c.name }
+func (c *fakeChecker) Required() bool                  { return c.required }
+func (c *fakeChecker) Check(ctx context.Context) error { return c.err }
+
+func newTestHandler() *HealthHandler {
+	return &HealthHandler{
+		ServiceName:  "test",
+		Version:      "dev",
+		CheckTimeout: time.Second,
+		passed:       make(map[string]bool),
+	}
+}
+
+func TestHealthFailsOnRequiredChecker(t *testing.T) {
+	h := newTestHandler()
+	h.Register(&fakeChecker{name: "database", required: true, err: errors.New("boom")})
+	h.Register(&fakeChecker{name: "cache", required: false, err: errors.New("boom")})
+
+	req := httptest.NewRequest(http.MethodGet, "/health", nil)
+	rec := httptest.NewRecorder()
+	h.Health(rec, req)
+
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("expected 503, got %d", rec.Code)
+	}
+}
+
+func TestHealthOKWhenOnlyOptionalCheckerFails(t *testing.T) {
+	h := newTestHandler()
+	h.Register(&fakeChecker{name: "database", required: true, err: nil})
+	h.Register(&fakeChecker{name: "cache", required: false, err: errors.New("boom")})
+
+	req := httptest.NewRequest(http.MethodGet, "/health", nil)
+	rec := httptest.NewRecorder()
+	h.Health(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+}
+
+func TestReadyGatedUntilRequiredCheckerHasPassedOnce(t *testing.T) {
+	h := newTestHandler()
+	checker := &fakeChecker{name: "database", required: true, err: errors.New("not up yet")}
+	h.Register(checker)
+
+	req := httptest.NewRequest(http.MethodGet, "/health/ready", nil)
+	rec := httptest.NewRecorder()
+	h.Ready(rec, req)
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("expected not ready while checker fails, got %d", rec.Code)
+	}
+
+	checker.err = nil
+	rec = httptest.NewRecorder()
+	h.Ready(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected ready once the required checker passes, got %d", rec.Code)
+	}
+}
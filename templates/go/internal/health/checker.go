@@ -0,0 +1,15 @@
+// Package health defines the pluggable dependency-check interface used by
+// handlers.HealthHandler, plus a set of built-in Checker implementations
+// for the backends most services in this template depend on.
+package health
+
+import "context"
+
+// Checker is a single named dependency check. Required checkers gate
+// readiness and failing one flips /health to 503; optional checkers are
+// reported but never fail the response.
+type Checker interface {
+	Name() string
+	Check(ctx context.Context) error
+	Required() bool
+}
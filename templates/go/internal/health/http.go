@@ -0,0 +1,47 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// HTTPChecker checks an upstream HTTP dependency by issuing a GET and
+// expecting a 2xx response.
+type HTTPChecker struct {
+	name     string
+	url      string
+	client   *http.Client
+	required bool
+}
+
+// NewHTTPChecker builds a Checker that GETs url and expects a 2xx status.
+// If client is nil, http.DefaultClient is used.
+func NewHTTPChecker(name, url string, client *http.Client, required bool) *HTTPChecker {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &HTTPChecker{name: name, url: url, client: client, required: required}
+}
+
+func (c *HTTPChecker) Name() string { return c.name }
+
+func (c *HTTPChecker) Required() bool { return c.required }
+
+func (c *HTTPChecker) Check(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url, nil)
+	if err != nil {
+		return fmt.Errorf("%s: build request: %w", c.name, err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s: %w", c.name, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("%s: unexpected status %d", c.name, resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package health
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// PostgresChecker checks connectivity to a Postgres database via Ping.
+type PostgresChecker struct {
+	db       *sql.DB
+	required bool
+}
+
+// NewPostgresChecker builds a Checker that pings db.
+func NewPostgresChecker(db *sql.DB, required bool) *PostgresChecker {
+	return &PostgresChecker{db: db, required: required}
+}
+
+func (c *PostgresChecker) Name() string { return "database" }
+
+func (c *PostgresChecker) Required() bool { return c.required }
+
+func (c *PostgresChecker) Check(ctx context.Context) error {
+	if err := c.db.PingContext(ctx); err != nil {
+		return fmt.Errorf("postgres ping: %w", err)
+	}
+	return nil
+}
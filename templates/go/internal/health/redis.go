@@ -0,0 +1,30 @@
+package health
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisChecker checks connectivity to Redis via PING.
+type RedisChecker struct {
+	client   *redis.Client
+	required bool
+}
+
+// NewRedisChecker builds a Checker that pings client.
+func NewRedisChecker(client *redis.Client, required bool) *RedisChecker {
+	return &RedisChecker{client: client, required: required}
+}
+
+func (c *RedisChecker) Name() string { return "cache" }
+
+func (c *RedisChecker) Required() bool { return c.required }
+
+func (c *RedisChecker) Check(ctx context.Context) error {
+	if err := c.client.Ping(ctx).Err(); err != nil {
+		return fmt.Errorf("redis ping: %w", err)
+	}
+	return nil
+}
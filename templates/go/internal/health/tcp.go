@@ -0,0 +1,32 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"net"
+)
+
+// TCPChecker checks reachability of a TCP address by dialing it.
+type TCPChecker struct {
+	name     string
+	addr     string
+	required bool
+}
+
+// NewTCPChecker builds a Checker that dials addr (host:port).
+func NewTCPChecker(name, addr string, required bool) *TCPChecker {
+	return &TCPChecker{name: name, addr: addr, required: required}
+}
+
+func (c *TCPChecker) Name() string { return c.name }
+
+func (c *TCPChecker) Required() bool { return c.required }
+
+func (c *TCPChecker) Check(ctx context.Context) error {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", c.addr)
+	if err != nil {
+		return fmt.Errorf("%s: dial %s: %w", c.name, c.addr, err)
+	}
+	return conn.Close()
+}
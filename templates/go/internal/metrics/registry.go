@@ -0,0 +1,59 @@
+// Package metrics provides the service's Prometheus registry.
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+)
+
+// durationBuckets covers 5ms to 10s, the range this template expects most
+// HTTP handlers to fall within.
+var durationBuckets = []float64{
+	0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10,
+}
+
+// Registry is the Prometheus registry shared across the service. It is an
+// fx-provided singleton so services can register custom collectors
+// instead of reaching for prometheus's global default registry.
+type Registry struct {
+	*prometheus.Registry
+
+	RequestsTotal    *prometheus.CounterVec
+	RequestDuration  *prometheus.HistogramVec
+	RequestsInFlight prometheus.Gauge
+	PanicsRecovered  *prometheus.CounterVec
+}
+
+// New builds a Registry pre-populated with the Go runtime and process
+// collectors, plus the RED metrics the template's Metrics middleware
+// records.
+func New() *Registry {
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(collectors.NewGoCollector())
+	reg.MustRegister(collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}))
+
+	r := &Registry{
+		Registry: reg,
+		RequestsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "http_requests_total",
+			Help: "Total number of HTTP requests.",
+		}, []string{"method", "path", "status"}),
+		RequestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "http_request_duration_seconds",
+			Help:    "HTTP request duration in seconds.",
+			Buckets: durationBuckets,
+		}, []string{"method", "path"}),
+		RequestsInFlight: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "http_requests_in_flight",
+			Help: "Number of HTTP requests currently being served.",
+		}),
+		PanicsRecovered: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "panics_recovered_total",
+			Help: "Total number of panics recovered by the Recovery middleware.",
+		}, []string{"route"}),
+	}
+
+	reg.MustRegister(r.RequestsTotal, r.RequestDuration, r.RequestsInFlight, r.PanicsRecovered)
+
+	return r
+}
@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/munbon/{{SERVICE_NAME}}/internal/config"
+)
+
+// CORS returns a CORS middleware that honors cfg's configured allowed
+// origins, methods, and headers, so exposing this to the internet is a
+// config change rather than a code change.
+func CORS(cfg config.CORS) func(http.Handler) http.Handler {
+	methods := strings.Join(cfg.AllowedMethods, ", ")
+	headers := strings.Join(cfg.AllowedHeaders, ", ")
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			origin := r.Header.Get("Origin")
+			if allowed := allowedOrigin(cfg.AllowedOrigins, origin); allowed != "" {
+				w.Header().Set("Access-Control-Allow-Origin", allowed)
+			}
+			w.Header().Set("Access-Control-Allow-Methods", methods)
+			w.Header().Set("Access-Control-Allow-Headers", headers)
+
+			if r.Method == http.MethodOptions {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// allowedOrigin returns the Access-Control-Allow-Origin value for origin
+// given the configured allow-list, or "" if origin is not allowed.
+func allowedOrigin(allowed []string, origin string) string {
+	for _, a := range allowed {
+		if a == "*" {
+			return "*"
+		}
+		if a == origin {
+			return origin
+		}
+	}
+	return ""
+}
@@ -1,15 +1,28 @@
 package middleware
 
 import (
-	"log"
+	"bufio"
+	"fmt"
+	"net"
 	"net/http"
 	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/munbon/{{SERVICE_NAME}}/pkg/logger"
 )
 
-// responseWriter wraps http.ResponseWriter to capture status code
+// RequestIDHeader is the header used to accept or mint a correlation ID.
+const RequestIDHeader = "X-Request-ID"
+
+// responseWriter wraps http.ResponseWriter to capture the status code and
+// bytes written, while remaining compatible with websocket/SSE handlers
+// that need to hijack the connection or flush incrementally.
 type responseWriter struct {
 	http.ResponseWriter
-	status int
+	status  int
+	written int64
 }
 
 // WriteHeader captures the status code
@@ -18,26 +31,58 @@ func (rw *responseWriter) WriteHeader(code int) {
 	rw.ResponseWriter.WriteHeader(code)
 }
 
-// Logging middleware logs all HTTP requests
-func Logging(logger *log.Logger) func(http.Handler) http.Handler {
+// Write captures bytes written
+func (rw *responseWriter) Write(b []byte) (int, error) {
+	n, err := rw.ResponseWriter.Write(b)
+	rw.written += int64(n)
+	return n, err
+}
+
+// Hijack implements http.Hijacker so websocket upgrades still work.
+func (rw *responseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := rw.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, fmt.Errorf("responseWriter: underlying ResponseWriter does not support hijacking")
+	}
+	return hijacker.Hijack()
+}
+
+// Flush implements http.Flusher so SSE handlers still work.
+func (rw *responseWriter) Flush() {
+	if flusher, ok := rw.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Logging middleware accepts or mints an X-Request-ID, attaches a
+// request-scoped logger to the context (retrievable via logger.FromContext),
+// and logs a single structured event per request.
+func Logging(log *zap.Logger) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			start := time.Now()
-			wrapped := &responseWriter{
-				ResponseWriter: w,
-				status:         http.StatusOK,
+			requestID := r.Header.Get(RequestIDHeader)
+			if requestID == "" {
+				requestID = uuid.NewString()
 			}
+			w.Header().Set(RequestIDHeader, requestID)
+
+			reqLog := log.With(zap.String("request_id", requestID))
+			r = r.WithContext(logger.NewContext(r.Context(), reqLog))
+
+			start := time.Now()
+			wrapped := &responseWriter{ResponseWriter: w, status: http.StatusOK}
 
 			next.ServeHTTP(wrapped, r)
 
-			logger.Printf(
-				"%s %s %s %d %s",
-				r.RemoteAddr,
-				r.Method,
-				r.URL.Path,
-				wrapped.status,
-				time.Since(start),
+			reqLog.Info("request",
+				zap.String("method", r.Method),
+				zap.String("path", r.URL.Path),
+				zap.Int("status", wrapped.status),
+				zap.Int64("bytes_written", wrapped.written),
+				zap.Int64("duration_ms", time.Since(start).Milliseconds()),
+				zap.String("remote_addr", r.RemoteAddr),
+				zap.String("user_agent", r.UserAgent()),
 			)
 		})
 	}
-}
\ No newline at end of file
+}
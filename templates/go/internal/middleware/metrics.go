@@ -0,0 +1,76 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/munbon/{{SERVICE_NAME}}/internal/metrics"
+)
+
+type routeHolderKey struct{}
+
+type routeHolder struct {
+	mu    sync.Mutex
+	route string
+}
+
+// unmatchedRoute labels requests that never matched a route (404s,
+// scanner noise, etc). It must stay a constant: falling back to the raw
+// URL path would let a client mint unbounded label values just by
+// requesting arbitrary paths.
+const unmatchedRoute = "unmatched"
+
+// SetRoute records the matched route pattern for the in-flight request so
+// Metrics labels by route instead of the raw URL path, which would
+// otherwise blow up cardinality for parameterized routes. It is called
+// from the framework-specific layer; since this template mounts chi, that
+// is the recordRoutePattern middleware in cmd/server/main.go.
+func SetRoute(ctx context.Context, route string) {
+	if h, ok := ctx.Value(routeHolderKey{}).(*routeHolder); ok {
+		h.mu.Lock()
+		h.route = route
+		h.mu.Unlock()
+	}
+}
+
+// routeFromContext returns the route pattern recorded via SetRoute, or
+// unmatchedRoute if none was recorded (e.g. the request didn't match any
+// route).
+func routeFromContext(ctx context.Context) string {
+	if h, ok := ctx.Value(routeHolderKey{}).(*routeHolder); ok {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		if h.route != "" {
+			return h.route
+		}
+	}
+	return unmatchedRoute
+}
+
+// Metrics middleware records the RED metrics (requests, errors via status,
+// duration) for every request into reg.
+func Metrics(reg *metrics.Registry) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			holder := &routeHolder{}
+			r = r.WithContext(context.WithValue(r.Context(), routeHolderKey{}, holder))
+
+			reg.RequestsInFlight.Inc()
+			defer reg.RequestsInFlight.Dec()
+
+			start := time.Now()
+			wrapped := &responseWriter{ResponseWriter: w, status: http.StatusOK}
+
+			next.ServeHTTP(wrapped, r)
+
+			route := routeFromContext(r.Context())
+			status := strconv.Itoa(wrapped.status)
+
+			reg.RequestsTotal.WithLabelValues(r.Method, route, status).Inc()
+			reg.RequestDuration.WithLabelValues(r.Method, route).Observe(time.Since(start).Seconds())
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+)
+
+func TestRouteFromContextFallsBackToUnmatchedConstant(t *testing.T) {
+	ctx := context.WithValue(context.Background(), routeHolderKey{}, &routeHolder{})
+
+	if got := routeFromContext(ctx); got != unmatchedRoute {
+		t.Errorf("routeFromContext() = %q, want %q", got, unmatchedRoute)
+	}
+}
+
+func TestRouteFromContextUsesRecordedRoute(t *testing.T) {
+	ctx := context.WithValue(context.Background(), routeHolderKey{}, &routeHolder{})
+
+	SetRoute(ctx, "/api/v1/users/{id}")
+
+	if got := routeFromContext(ctx); got != "/api/v1/users/{id}" {
+		t.Errorf("routeFromContext() = %q, want recorded route", got)
+	}
+}
+
+func TestRouteFromContextWithoutHolderFallsBackToUnmatchedConstant(t *testing.T) {
+	if got := routeFromContext(context.Background()); got != unmatchedRoute {
+		t.Errorf("routeFromContext() = %q, want %q", got, unmatchedRoute)
+	}
+}
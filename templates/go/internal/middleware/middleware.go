@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/justinas/alice"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+
+	"github.com/munbon/{{SERVICE_NAME}}/internal/config"
+	"github.com/munbon/{{SERVICE_NAME}}/internal/metrics"
+)
+
+// Chain wraps an http.Handler with the template's shared middleware.
+type Chain func(http.Handler) http.Handler
+
+// Params are New's fx-injected dependencies. OnPanic is optional: services
+// that don't provide one simply don't get a panic callback.
+type Params struct {
+	fx.In
+
+	Log     *zap.Logger
+	Cfg     *config.Config
+	Metrics *metrics.Registry
+	OnPanic OnPanicFunc `optional:"true"`
+}
+
+// New builds the middleware chain applied to every request: metrics,
+// structured logging, panic recovery, then CORS. It is an fx constructor
+// so adding a new cross-cutting concern to the template means extending
+// this function rather than editing every service's main.go.
+func New(p Params) Chain {
+	c := alice.New(
+		Metrics(p.Metrics),
+		Logging(p.Log),
+		Recovery(p.Log, p.Metrics, p.OnPanic),
+		CORS(p.Cfg.CORS),
+	)
+
+	return func(next http.Handler) http.Handler {
+		return c.Then(next)
+	}
+}
@@ -1,38 +1,96 @@
 package middleware
 
 import (
+	"context"
 	"encoding/json"
-	"log"
+	"errors"
+	"net"
 	"net/http"
-	"runtime/debug"
+	"runtime"
+
+	"go.uber.org/zap"
+
+	"github.com/munbon/{{SERVICE_NAME}}/internal/metrics"
+	"github.com/munbon/{{SERVICE_NAME}}/pkg/logger"
 )
 
+// maxStackSize bounds the recovered stack trace, since runtime/debug.Stack
+// is unbounded and a hot panic loop would otherwise flood logs.
+const maxStackSize = 64 * 1024
+
 // ErrorResponse represents an error response
 type ErrorResponse struct {
 	Error   string `json:"error"`
 	Message string `json:"message"`
 }
 
-// Recovery middleware recovers from panics and returns 500 error
-func Recovery(logger *log.Logger) func(http.Handler) http.Handler {
+// OnPanicFunc is invoked for every true panic, after it has been logged and
+// counted, so services can additionally report it to Sentry/OTel. It is
+// resolved as an optional fx dependency; services that don't provide one
+// simply don't get called.
+type OnPanicFunc func(ctx context.Context, recovered any, stack []byte)
+
+// Recovery middleware recovers from panics. It distinguishes a client that
+// has already disconnected (http.ErrAbortHandler, net.ErrClosed) - logged
+// at debug level with no response written, since the connection is gone -
+// from a true panic, which is logged at error level with request context
+// and a bounded stack trace, counted via panics_recovered_total, and
+// reported to onPanic before a 500 is written.
+func Recovery(log *zap.Logger, reg *metrics.Registry, onPanic OnPanicFunc) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			defer func() {
-				if err := recover(); err != nil {
-					logger.Printf("panic recovered: %v\n%s", err, debug.Stack())
+				rec := recover()
+				if rec == nil {
+					return
+				}
+
+				reqLog := logger.FromContext(r.Context())
+
+				if clientGone(rec) {
+					reqLog.Debug("client disconnected during handler", zap.Any("panic", rec))
+					return
+				}
 
-					w.Header().Set("Content-Type", "application/json")
-					w.WriteHeader(http.StatusInternalServerError)
+				buf := make([]byte, maxStackSize)
+				n := runtime.Stack(buf, false)
+				stack := buf[:n]
 
-					response := ErrorResponse{
-						Error:   "internal_server_error",
-						Message: "An internal server error occurred",
-					}
-					json.NewEncoder(w).Encode(response)
+				reqLog.Error("panic recovered",
+					zap.String("method", r.Method),
+					zap.String("path", r.URL.Path),
+					zap.String("remote_addr", r.RemoteAddr),
+					zap.Any("panic", rec),
+					zap.ByteString("stack", stack),
+				)
+
+				reg.PanicsRecovered.WithLabelValues(routeFromContext(r.Context())).Inc()
+
+				if onPanic != nil {
+					onPanic(r.Context(), rec, stack)
 				}
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusInternalServerError)
+				json.NewEncoder(w).Encode(ErrorResponse{
+					Error:   "internal_server_error",
+					Message: "An internal server error occurred",
+				})
 			}()
 
 			next.ServeHTTP(w, r)
 		})
 	}
-}
\ No newline at end of file
+}
+
+// clientGone reports whether a recovered panic value indicates the client
+// already went away, rather than a real handler bug.
+func clientGone(rec any) bool {
+	if rec == http.ErrAbortHandler {
+		return true
+	}
+	if err, ok := rec.(error); ok {
+		return errors.Is(err, net.ErrClosed)
+	}
+	return false
+}
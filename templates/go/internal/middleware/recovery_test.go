@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"testing"
+)
+
+func TestClientGone(t *testing.T) {
+	tests := []struct {
+		name string
+		rec  any
+		want bool
+	}{
+		{"abort handler", http.ErrAbortHandler, true},
+		{"wrapped net.ErrClosed", fmt.Errorf("write: %w", net.ErrClosed), true},
+		{"unrelated error", errors.New("nil pointer dereference"), false},
+		{"non-error panic value", "boom", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := clientGone(tt.rec); got != tt.want {
+				t.Errorf("clientGone(%v) = %v, want %v", tt.rec, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+// Package server wires the HTTP server into the fx lifecycle.
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+
+	"github.com/munbon/{{SERVICE_NAME}}/internal/config"
+)
+
+// shutdownTimeout bounds how long the server waits for in-flight requests
+// to finish during OnStop.
+const shutdownTimeout = 10 * time.Second
+
+// New builds the *http.Server and registers its startup/shutdown with the
+// fx lifecycle, so every service scaffolded from this template gets the
+// same graceful-shutdown behavior for free.
+func New(lc fx.Lifecycle, shutdowner fx.Shutdowner, cfg *config.Config, log *zap.Logger, handler http.Handler) *http.Server {
+	srv := &http.Server{
+		Addr:    fmt.Sprintf(":%s", cfg.Server.Port),
+		Handler: handler,
+	}
+
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			log.Info("starting server", zap.String("port", cfg.Server.Port))
+			go func() {
+				if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+					log.Error("server error", zap.Error(err))
+					if shutdownErr := shutdowner.Shutdown(fx.ExitCode(1)); shutdownErr != nil {
+						log.Error("failed to signal shutdown after server error", zap.Error(shutdownErr))
+					}
+				}
+			}()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			log.Info("shutting down server")
+			shutdownCtx, cancel := context.WithTimeout(ctx, shutdownTimeout)
+			defer cancel()
+			return srv.Shutdown(shutdownCtx)
+		},
+	})
+
+	return srv
+}
@@ -0,0 +1,25 @@
+package logger
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+)
+
+type ctxKey struct{}
+
+// NewContext returns a copy of ctx carrying log, retrievable via
+// FromContext. Middleware.Logging uses this to attach a request-scoped
+// logger so downstream handlers log already tagged with the request ID.
+func NewContext(ctx context.Context, log *zap.Logger) context.Context {
+	return context.WithValue(ctx, ctxKey{}, log)
+}
+
+// FromContext returns the logger attached to ctx via NewContext, or a
+// no-op logger if none is attached.
+func FromContext(ctx context.Context) *zap.Logger {
+	if log, ok := ctx.Value(ctxKey{}).(*zap.Logger); ok {
+		return log
+	}
+	return zap.NewNop()
+}
@@ -0,0 +1,32 @@
+// Package logger provides the service's structured logger, built from
+// config so every service scaffolded from this template logs consistently.
+package logger
+
+import (
+	"fmt"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/munbon/{{SERVICE_NAME}}/internal/config"
+)
+
+// New builds a *zap.Logger appropriate for cfg.Server.Environment: a human-readable
+// development encoder outside production, and a JSON production encoder
+// otherwise. cfg.Logger.Level sets the minimum enabled level in both cases.
+func New(cfg *config.Config) (*zap.Logger, error) {
+	var level zapcore.Level
+	if err := level.UnmarshalText([]byte(cfg.Logger.Level)); err != nil {
+		return nil, fmt.Errorf("logger: invalid LOG_LEVEL %q: %w", cfg.Logger.Level, err)
+	}
+
+	var zapCfg zap.Config
+	if cfg.Server.Environment == "production" {
+		zapCfg = zap.NewProductionConfig()
+	} else {
+		zapCfg = zap.NewDevelopmentConfig()
+	}
+	zapCfg.Level = zap.NewAtomicLevelAt(level)
+
+	return zapCfg.Build()
+}